@@ -9,8 +9,19 @@ import (
 type hoverable interface {
 	Shape
 	IsWithin(Vec) bool
+	SetStyle(Style)
 }
 
+// ButtonState represents the visual/interactive state of a button.
+type ButtonState int
+
+const (
+	StateNormal   ButtonState = iota // no interaction taking place
+	StateHover                       // cursor is over the button
+	StatePressed                     // button is being held down
+	StateDisabled                    // button is disabled and ignores input
+)
+
 // Button can be build on top of shapes to create pressable buttons.
 type Button struct {
 	Shape     hoverable        // the base shape the button is built on
@@ -22,17 +33,133 @@ type Button struct {
 	prevMouseState MouseState
 	prevMouseLoc   Vec
 	prevLabel      string
+
+	pressed      bool
+	disabled     bool
+	stateStyles  map[ButtonState]Style
+	labelColours map[ButtonState]color.Color
+	theme        *Theme
+
+	focused        bool
+	FocusRingStyle Style // style used to draw the focus ring
 }
 
 // NewButton constructs a new button from any shape that satisfies the buttonable interface.
+// The button's default per-state styles are taken from the currently active theme.
 func NewButton(shape hoverable, fontPath string) *Button {
-	return &Button{
-		Shape:     shape,
-		Label:     NewText("", shape.GetPos(), fontPath),
-		CB:        func(MouseState) { fmt.Println("Warning: Button callback not configured") },
-		Trigger:   LeftClick,
-		Behaviour: OnAll,
+	theme := CurrentTheme()
+	b := &Button{
+		Shape:        shape,
+		Label:        NewText("", shape.GetPos(), fontPath),
+		CB:           func(MouseState) { fmt.Println("Warning: Button callback not configured") },
+		Trigger:      LeftClick,
+		Behaviour:    OnAll,
+		stateStyles:  make(map[ButtonState]Style),
+		labelColours: make(map[ButtonState]color.Color),
+		theme:        theme,
+		FocusRingStyle: Style{
+			Colour:    color.RGBA{0x00, 0x78, 0xff, 0xff},
+			Thickness: 2,
+		},
+	}
+	b.seedThemeStates(theme)
+	return b
+}
+
+// seedThemeStates copies theme's per-state styles/label colours into the
+// button, skipping StateNormal: the caller is expected to have already set
+// the underlying shape's own style (e.g. via WithStyle) for the normal case,
+// and seeding it here would silently overwrite that the moment Draw runs.
+func (b *Button) seedThemeStates(theme *Theme) {
+	for state, style := range theme.ButtonStyles {
+		if state == StateNormal {
+			continue
+		}
+		b.stateStyles[state] = style
+	}
+	for state, c := range theme.ButtonLabelColour {
+		if state == StateNormal {
+			continue
+		}
+		b.labelColours[state] = c
+	}
+}
+
+// SetTheme overrides the theme used by this button, re-seeding its per-state
+// styles and label colours from it.
+func (b *Button) SetTheme(theme *Theme) *Button {
+	b.theme = theme
+	b.seedThemeStates(theme)
+	return b
+}
+
+// SetStateStyle configures the shape style to use when the button is in the given state.
+// States without a configured style fall back to the shape's own style.
+func (b *Button) SetStateStyle(state ButtonState, s Style) *Button {
+	b.stateStyles[state] = s
+	return b
+}
+
+// SetLabelStateColour configures the label text colour to use when the button is in
+// the given state. States without a configured colour fall back to the label's own colour.
+func (b *Button) SetLabelStateColour(state ButtonState, c color.Color) *Button {
+	b.labelColours[state] = c
+	return b
+}
+
+// SetDisabled sets whether the button is disabled. A disabled button ignores mouse
+// input and does not execute its callback.
+func (b *Button) SetDisabled(disabled bool) *Button {
+	b.disabled = disabled
+	return b
+}
+
+// IsDisabled returns whether the button is disabled.
+func (b *Button) IsDisabled() bool {
+	return b.disabled
+}
+
+// State returns the button's current state.
+func (b *Button) State() ButtonState {
+	switch {
+	case b.disabled:
+		return StateDisabled
+	case b.pressed:
+		return StatePressed
+	case b.IsHovering():
+		return StateHover
+	default:
+		return StateNormal
+	}
+}
+
+// Focus gives the button keyboard focus.
+func (b *Button) Focus() {
+	b.focused = true
+}
+
+// Blur removes keyboard focus from the button.
+func (b *Button) Blur() {
+	b.focused = false
+}
+
+// IsFocused returns whether the button currently has keyboard focus.
+func (b *Button) IsFocused() bool {
+	return b.focused
+}
+
+// HandleKey activates the button when focused and Enter or Space is pressed,
+// synthesising a MouseState as though the button's trigger had been clicked.
+// It returns true if the event was consumed.
+func (b *Button) HandleKey(e KeyEvent) bool {
+	if !b.focused || b.disabled {
+		return false
+	}
+	if e.Key != KeyEnter && e.Key != KeySpace {
+		return false
 	}
+	b.CB(b.Trigger)
+	return true
 }
 
 // SetCallback configures a callback function to execute every time a press
@@ -43,22 +170,41 @@ func (b *Button) SetCallback(callback func(MouseState)) *Button {
 	return b
 }
 
+// shapeTopLeft returns the top-left corner of the button's underlying shape.
+// Most shapes (Rect, Symbol) anchor GetPos at their top-left corner already;
+// Circle is the exception, anchoring GetPos at its centre instead.
+func (b *Button) shapeTopLeft() Vec {
+	switch b.Shape.(type) {
+	case *Circle:
+		p := b.Shape.GetPos()
+		return Vec{p.X - b.Shape.Width()/2, p.Y - b.Shape.Height()/2}
+	default:
+		return b.Shape.GetPos()
+	}
+}
+
 // Draw draws the button onto the frame buffer.
 func (b *Button) Draw(buf *FrameBuffer) {
+	state := b.State()
+	if style, ok := b.stateStyles[state]; ok {
+		b.Shape.SetStyle(style)
+	}
 	b.Shape.Draw(buf)
 
 	// Align to centre of underlying shape
-	b.Label.SetPos(func() Vec {
-		switch b.Shape.(type) {
-		case *Rect:
-			p := b.Shape.GetPos()
-			return Vec{p.X + b.Shape.Width()/2, p.Y + b.Shape.Height()/2}
-		default:
-			return b.Shape.GetPos()
-		}
-	}())
+	topLeft := b.shapeTopLeft()
+	b.Label.SetPos(Vec{topLeft.X + b.Shape.Width()/2, topLeft.Y + b.Shape.Height()/2})
+
+	if c, ok := b.labelColours[state]; ok {
+		b.Label.SetColour(c)
+	}
 
 	b.Label.Draw(buf)
+
+	if b.focused {
+		ring := NewRect(b.Shape.Width(), b.Shape.Height(), b.shapeTopLeft(), WithStyle(b.FocusRingStyle))
+		ring.Draw(buf)
+	}
 }
 
 // ButtonBehaviour represents how a button responds to being pressed.
@@ -79,6 +225,19 @@ func (b *Button) Update(win *Window) {
 	currentMouseState := win.MouseButtonState()
 	hovering := b.Shape.IsWithin(win.MouseLocation())
 
+	if b.disabled {
+		b.prevMouseState = currentMouseState
+		b.prevMouseLoc = win.MouseLocation()
+		return
+	}
+
+	if hovering && b.prevMouseState == NoClick && currentMouseState == b.Trigger {
+		b.pressed = true
+	}
+	if b.pressed && (currentMouseState == NoClick || !hovering) {
+		b.pressed = false
+	}
+
 	switch b.Behaviour {
 	case OnAll:
 		b.CB(currentMouseState)
@@ -0,0 +1,38 @@
+// This example builds a toolbar (a horizontal Flex) above a 2x2 Grid of
+// panels, with the toolbar itself nested inside a vertical Flex. It exists
+// to prove that layout containers can be nested: the outer Flex resolves the
+// toolbar's and grid's bounds via layout.Arranger rather than trying to
+// resize them directly, so each nested container gets a real, non-zero
+// region to arrange its own children into.
+package main
+
+import (
+	tgl "github.com/zac460/turdgl"
+	"github.com/zac460/turdgl/layout"
+)
+
+func main() {
+	buf := &tgl.FrameBuffer{}
+
+	toolbar := layout.NewFlex(layout.Horizontal,
+		layout.Fixed(tgl.NewRect(0, 0, tgl.Vec{}), 40),
+		layout.Flexible(tgl.NewRect(0, 0, tgl.Vec{}), 1),
+		layout.Fixed(tgl.NewRect(0, 0, tgl.Vec{}), 40),
+	)
+	toolbar.Spacing = 4
+
+	panels := layout.NewGrid(2, 2,
+		tgl.NewRect(0, 0, tgl.Vec{}),
+		tgl.NewRect(0, 0, tgl.Vec{}),
+		tgl.NewRect(0, 0, tgl.Vec{}),
+		tgl.NewRect(0, 0, tgl.Vec{}),
+	)
+	panels.Spacing = 4
+
+	root := layout.NewFlex(layout.Vertical,
+		layout.Fixed(toolbar, 48),
+		layout.Flexible(panels, 1),
+	)
+
+	root.Arrange(layout.Rect{Pos: tgl.Vec{X: 0, Y: 0}, Width: 800, Height: 600}, buf)
+}
@@ -0,0 +1,87 @@
+package turdgl
+
+// Focusable is implemented by widgets that can receive keyboard focus.
+type Focusable interface {
+	Focus()
+	Blur()
+	HandleKey(KeyEvent) bool // returns true if the event was consumed
+}
+
+// FocusManager maintains an ordered ring of focusable widgets and cycles
+// focus between them in response to Tab/Shift-Tab.
+type FocusManager struct {
+	widgets []Focusable
+	current int // index into widgets, -1 if nothing is focused
+}
+
+// NewFocusManager constructs an empty FocusManager.
+func NewFocusManager() *FocusManager {
+	return &FocusManager{current: -1}
+}
+
+// Register adds a widget to the focus ring.
+func (fm *FocusManager) Register(f Focusable) {
+	fm.widgets = append(fm.widgets, f)
+}
+
+// Next moves focus to the next widget in the ring, wrapping around.
+func (fm *FocusManager) Next() {
+	if len(fm.widgets) == 0 {
+		return
+	}
+	if fm.current >= 0 {
+		fm.widgets[fm.current].Blur()
+	}
+	fm.current = (fm.current + 1) % len(fm.widgets)
+	fm.widgets[fm.current].Focus()
+}
+
+// Prev moves focus to the previous widget in the ring, wrapping around.
+func (fm *FocusManager) Prev() {
+	if len(fm.widgets) == 0 {
+		return
+	}
+	if fm.current >= 0 {
+		fm.widgets[fm.current].Blur()
+	}
+	fm.current = (fm.current - 1 + len(fm.widgets)) % len(fm.widgets)
+	fm.widgets[fm.current].Focus()
+}
+
+// Focused returns the currently focused widget, or nil if nothing is focused.
+func (fm *FocusManager) Focused() Focusable {
+	if fm.current < 0 || fm.current >= len(fm.widgets) {
+		return nil
+	}
+	return fm.widgets[fm.current]
+}
+
+// HandleKey dispatches a key event to the focus manager. Tab and Shift-Tab
+// cycle focus; any other key is forwarded to the focused widget.
+func (fm *FocusManager) HandleKey(e KeyEvent) bool {
+	switch {
+	case e.Key == KeyTab && e.Shift:
+		fm.Prev()
+		return true
+	case e.Key == KeyTab:
+		fm.Next()
+		return true
+	}
+	if f := fm.Focused(); f != nil {
+		return f.HandleKey(e)
+	}
+	return false
+}
+
+// FocusManager returns the window's focus manager, creating one on first use.
+func (w *Window) FocusManager() *FocusManager {
+	if w.focusManager == nil {
+		w.focusManager = NewFocusManager()
+	}
+	return w.focusManager
+}
+
+// RegisterFocusable adds a widget to the window's focus ring.
+func (w *Window) RegisterFocusable(f Focusable) {
+	w.FocusManager().Register(f)
+}
@@ -0,0 +1,17 @@
+package turdgl
+
+// Key identifies a keyboard key.
+type Key int
+
+const (
+	KeyTab Key = iota
+	KeyEnter
+	KeySpace
+	KeyEscape
+)
+
+// KeyEvent represents a single keyboard event.
+type KeyEvent struct {
+	Key   Key
+	Shift bool
+}
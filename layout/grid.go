@@ -0,0 +1,81 @@
+package layout
+
+import (
+	tgl "github.com/zac460/turdgl"
+)
+
+// Grid arranges its children into a fixed number of evenly-sized rows and columns.
+type Grid struct {
+	Rows, Cols int
+	Spacing    float64
+	Padding    float64
+	Children   []tgl.Shape // filled row-major; nil entries leave a gap
+
+	pos    tgl.Vec
+	width  float64
+	height float64
+}
+
+// NewGrid constructs a new Grid container with the given number of rows and columns.
+func NewGrid(rows, cols int, children ...tgl.Shape) *Grid {
+	return &Grid{Rows: rows, Cols: cols, Children: children}
+}
+
+// GetPos returns the container's position.
+func (g *Grid) GetPos() tgl.Vec { return g.pos }
+
+// SetPos sets the container's position.
+func (g *Grid) SetPos(pos tgl.Vec) { g.pos = pos }
+
+// Move moves the container, and everything arranged within it, by mov.
+func (g *Grid) Move(mov tgl.Vec) {
+	g.pos = tgl.Add(g.pos, mov)
+	for _, c := range g.Children {
+		if c != nil {
+			c.Move(mov)
+		}
+	}
+}
+
+// Width returns the width last used to arrange the container.
+func (g *Grid) Width() float64 { return g.width }
+
+// Height returns the height last used to arrange the container.
+func (g *Grid) Height() float64 { return g.height }
+
+// Arrange resolves the position and size of every cell against bounds, then
+// draws the whole tree into buf.
+func (g *Grid) Arrange(bounds Rect, buf *tgl.FrameBuffer) {
+	g.pos = bounds.Pos
+	g.width = bounds.Width
+	g.height = bounds.Height
+
+	if g.Rows == 0 || g.Cols == 0 {
+		return
+	}
+
+	innerW := bounds.Width - 2*g.Padding - g.Spacing*float64(g.Cols-1)
+	innerH := bounds.Height - 2*g.Padding - g.Spacing*float64(g.Rows-1)
+	cellW := innerW / float64(g.Cols)
+	cellH := innerH / float64(g.Rows)
+
+	for i, child := range g.Children {
+		if child == nil {
+			continue
+		}
+		row, col := i/g.Cols, i%g.Cols
+		if row >= g.Rows {
+			break
+		}
+		cellPos := tgl.Vec{
+			X: bounds.Pos.X + g.Padding + float64(col)*(cellW+g.Spacing),
+			Y: bounds.Pos.Y + g.Padding + float64(row)*(cellH+g.Spacing),
+		}
+		arrangeChild(child, cellPos, cellW, cellH, buf)
+	}
+}
+
+// Draw draws the container using its last-arranged bounds.
+func (g *Grid) Draw(buf *tgl.FrameBuffer) {
+	g.Arrange(Rect{Pos: g.pos, Width: g.width, Height: g.height}, buf)
+}
@@ -0,0 +1,187 @@
+// Package layout provides containers that arrange turdgl shapes into rows,
+// columns and grids without the caller computing pixel positions by hand.
+package layout
+
+import (
+	tgl "github.com/zac460/turdgl"
+)
+
+// Axis represents the direction along which a Flex container lays out its children.
+type Axis int
+
+const (
+	Horizontal Axis = iota // children are arranged left to right
+	Vertical               // children are arranged top to bottom
+)
+
+// Rect defines the rectangular region a container is arranged within.
+type Rect struct {
+	Pos           tgl.Vec
+	Width, Height float64
+}
+
+// Insets specifies padding applied around a child on each side.
+type Insets struct {
+	Top, Right, Bottom, Left float64
+}
+
+// Child describes how a single shape should be sized within a Flex container.
+type Child struct {
+	Shape  tgl.Shape
+	Size   float64 // used when Flex is false
+	Weight float64 // used when Flex is true
+	Flex   bool
+	Insets Insets
+}
+
+// Fixed declares a child with an explicit size along the container's axis.
+func Fixed(shape tgl.Shape, size float64) Child {
+	return Child{Shape: shape, Size: size}
+}
+
+// Flexible declares a child that shares the remaining space proportionally to weight.
+func Flexible(shape tgl.Shape, weight float64) Child {
+	return Child{Shape: shape, Weight: weight, Flex: true}
+}
+
+// Inset wraps a child with padding applied on each side before it is arranged.
+func Inset(child Child, insets Insets) Child {
+	child.Insets = insets
+	return child
+}
+
+// resizable is satisfied by shapes that support being resized by a container.
+type resizable interface {
+	SetSize(width, height float64)
+}
+
+// Arranger is satisfied by nested containers (Flex, Grid): rather than being
+// resized and drawn in place like a leaf shape, they need their bounds
+// resolved by the parent so they can in turn arrange their own children.
+type Arranger interface {
+	tgl.Shape
+	Arrange(bounds Rect, buf *tgl.FrameBuffer)
+}
+
+// arrangeChild positions shape at childPos and, depending on what it
+// implements, either hands it the fully resolved bounds (for a nested
+// container), resizes it to fill those bounds (for a resizable leaf shape),
+// or leaves its size untouched (e.g. Text) before drawing it.
+func arrangeChild(shape tgl.Shape, childPos tgl.Vec, width, height float64, buf *tgl.FrameBuffer) {
+	if arranger, ok := shape.(Arranger); ok {
+		arranger.Arrange(Rect{Pos: childPos, Width: width, Height: height}, buf)
+		return
+	}
+
+	shape.SetPos(childPos)
+	if r, ok := shape.(resizable); ok {
+		r.SetSize(width, height)
+	}
+	shape.Draw(buf)
+}
+
+// Flex arranges its children into a single row or column.
+type Flex struct {
+	Axis     Axis
+	Spacing  float64
+	Padding  float64
+	Children []Child
+
+	pos    tgl.Vec
+	width  float64
+	height float64
+}
+
+// NewFlex constructs a new Flex container along the given axis.
+func NewFlex(axis Axis, children ...Child) *Flex {
+	return &Flex{Axis: axis, Children: children}
+}
+
+// GetPos returns the container's position.
+func (f *Flex) GetPos() tgl.Vec { return f.pos }
+
+// SetPos sets the container's position.
+func (f *Flex) SetPos(pos tgl.Vec) { f.pos = pos }
+
+// Move moves the container, and everything arranged within it, by mov.
+func (f *Flex) Move(mov tgl.Vec) {
+	f.pos = tgl.Add(f.pos, mov)
+	for _, c := range f.Children {
+		c.Shape.Move(mov)
+	}
+}
+
+// Width returns the width last used to arrange the container.
+func (f *Flex) Width() float64 { return f.width }
+
+// Height returns the height last used to arrange the container.
+func (f *Flex) Height() float64 { return f.height }
+
+// Arrange resolves the position and size of every child against bounds, then
+// draws the whole tree into buf.
+func (f *Flex) Arrange(bounds Rect, buf *tgl.FrameBuffer) {
+	f.pos = bounds.Pos
+	f.width = bounds.Width
+	f.height = bounds.Height
+
+	main, cross := bounds.Width, bounds.Height
+	if f.Axis == Vertical {
+		main, cross = bounds.Height, bounds.Width
+	}
+	main -= 2 * f.Padding
+
+	var fixedTotal, weightTotal float64
+	for _, c := range f.Children {
+		if c.Flex {
+			weightTotal += c.Weight
+		} else {
+			fixedTotal += c.Size
+		}
+	}
+	spacingTotal := f.Spacing * float64(maxInt(len(f.Children)-1, 0))
+	remaining := main - fixedTotal - spacingTotal
+
+	cursor := f.Padding
+	for _, c := range f.Children {
+		size := c.Size
+		if c.Flex && weightTotal > 0 {
+			size = remaining * (c.Weight / weightTotal)
+		}
+
+		var childPos tgl.Vec
+		if f.Axis == Horizontal {
+			childPos = tgl.Vec{X: bounds.Pos.X + cursor + c.Insets.Left, Y: bounds.Pos.Y + f.Padding + c.Insets.Top}
+		} else {
+			childPos = tgl.Vec{X: bounds.Pos.X + f.Padding + c.Insets.Left, Y: bounds.Pos.Y + cursor + c.Insets.Top}
+		}
+
+		innerCross := cross - 2*f.Padding - c.Insets.Top - c.Insets.Bottom
+		innerMain := size - c.Insets.Left - c.Insets.Right
+		if f.Axis == Vertical {
+			innerCross = cross - 2*f.Padding - c.Insets.Left - c.Insets.Right
+			innerMain = size - c.Insets.Top - c.Insets.Bottom
+		}
+
+		var childW, childH float64
+		if f.Axis == Horizontal {
+			childW, childH = innerMain, innerCross
+		} else {
+			childW, childH = innerCross, innerMain
+		}
+		arrangeChild(c.Shape, childPos, childW, childH, buf)
+
+		cursor += size + f.Spacing
+	}
+}
+
+// Draw draws the container using its last-arranged bounds.
+func (f *Flex) Draw(buf *tgl.FrameBuffer) {
+	f.Arrange(Rect{Pos: f.pos, Width: f.width, Height: f.height}, buf)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
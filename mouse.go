@@ -0,0 +1,11 @@
+package turdgl
+
+// MouseState represents the state of the mouse buttons.
+type MouseState int
+
+const (
+	NoClick MouseState = iota
+	LeftClick
+	RightClick
+	MiddleClick
+)
@@ -0,0 +1,190 @@
+// Package raster implements low-level 2D rasterisation algorithms: the
+// midpoint circle algorithm for filled shapes and Xiaolin Wu's algorithm for
+// antialiased outlines. Routines are expressed purely in terms of
+// coordinates and a plot callback, so they have no dependency on any
+// particular framebuffer representation.
+package raster
+
+import (
+	"math"
+	"sort"
+)
+
+// PlotFunc is called for every pixel produced by a rasterisation routine.
+// coverage is in the range [0,1] and indicates how much of the pixel the
+// line/arc covers; 1 means fully opaque, values in between should be
+// alpha-blended by the caller against the destination colour.
+type PlotFunc func(x, y int, coverage float64)
+
+// DrawLine rasterises the line from (x0,y0) to (x1,y1) using Xiaolin Wu's
+// antialiasing algorithm: for each step along the major axis, the two
+// adjacent pixels straddling the true line are blended in proportion to
+// their distance from it.
+func DrawLine(x0, y0, x1, y1 float64, plot PlotFunc) {
+	steep := math.Abs(y1-y0) > math.Abs(x1-x0)
+	if steep {
+		x0, y0 = y0, x0
+		x1, y1 = y1, x1
+	}
+	if x0 > x1 {
+		x0, x1 = x1, x0
+		y0, y1 = y1, y0
+	}
+
+	dx := x1 - x0
+	dy := y1 - y0
+	gradient := 1.0
+	if dx != 0 {
+		gradient = dy / dx
+	}
+
+	plotPoint := func(x, y float64, coverage float64) {
+		xi, yi := int(math.Floor(x)), int(math.Floor(y))
+		if steep {
+			plot(yi, xi, coverage)
+		} else {
+			plot(xi, yi, coverage)
+		}
+	}
+
+	y := y0
+	for x := x0; x <= x1; x++ {
+		yFloor := math.Floor(y)
+		frac := y - yFloor
+		plotPoint(x, yFloor, 1-frac)
+		plotPoint(x, yFloor+1, frac)
+		y += gradient
+	}
+}
+
+// DrawCircleFilled fills a circle of radius r centred on (cx,cy) using the
+// midpoint circle algorithm: y is iterated from 0 while y <= x, the decision
+// variable d = 1 - r determines when x should step inward, and a horizontal
+// span is filled between -x and +x for each of the circle's octant
+// reflections. The span ends are found in O(r) steps, without testing every
+// pixel of the bounding box.
+func DrawCircleFilled(cx, cy, r float64, plot PlotFunc) {
+	radius := int(math.Round(r))
+	centreX, centreY := int(math.Round(cx)), int(math.Round(cy))
+
+	x, y := radius, 0
+	d := 1 - radius
+
+	span := func(y, x0, x1 int) {
+		for x := x0; x <= x1; x++ {
+			plot(centreX+x, centreY+y, 1)
+		}
+	}
+
+	for y <= x {
+		span(y, -x, x)
+		span(-y, -x, x)
+		span(x, -y, y)
+		span(-x, -y, y)
+
+		y++
+		if d < 0 {
+			d += 2*y + 1
+		} else {
+			x--
+			d += 2*(y-x) + 1
+		}
+	}
+}
+
+// DrawCircleOutline draws an antialiased circle outline of radius r centred
+// on (cx,cy). One octant is stepped and each step is mirrored into all eight;
+// at each step the two candidate pixels are blended using Xiaolin Wu's
+// algorithm in proportion to their distance from the true circle.
+func DrawCircleOutline(cx, cy, r float64, plot PlotFunc) {
+	centreX, centreY := int(math.Round(cx)), int(math.Round(cy))
+
+	plotOctants := func(x, y int, coverage float64) {
+		points := [8][2]int{
+			{x, y}, {y, x}, {-x, y}, {-y, x},
+			{x, -y}, {y, -x}, {-x, -y}, {-y, -x},
+		}
+		for _, p := range points {
+			plot(centreX+p[0], centreY+p[1], coverage)
+		}
+	}
+
+	x := 0.0
+	for x <= r/math.Sqrt2 {
+		y := math.Sqrt(r*r - x*x)
+		yFloor := math.Floor(y)
+		frac := y - yFloor
+
+		plotOctants(int(x), int(yFloor), 1-frac)
+		plotOctants(int(x), int(yFloor)+1, frac)
+
+		x++
+	}
+}
+
+// DrawEllipse draws an antialiased ellipse outline with semi-axes rx and ry
+// centred on (cx,cy), using the same Wu-style blend as DrawCircleOutline but
+// parameterised by angle rather than by octant.
+func DrawEllipse(cx, cy, rx, ry float64, plot PlotFunc) {
+	circumferenceEstimate := math.Pi * (3*(rx+ry) - math.Sqrt((3*rx+ry)*(rx+3*ry)))
+	steps := int(math.Max(circumferenceEstimate, 16))
+
+	prevX, prevY := cx+rx, cy
+	for i := 1; i <= steps; i++ {
+		theta := 2 * math.Pi * float64(i) / float64(steps)
+		x := cx + rx*math.Cos(theta)
+		y := cy + ry*math.Sin(theta)
+		DrawLine(prevX, prevY, x, y, plot)
+		prevX, prevY = x, y
+	}
+}
+
+// DrawPolygon draws the closed outline connecting the given vertices in order.
+func DrawPolygon(points [][2]float64, plot PlotFunc) {
+	if len(points) < 2 {
+		return
+	}
+	for i := range points {
+		next := points[(i+1)%len(points)]
+		DrawLine(points[i][0], points[i][1], next[0], next[1], plot)
+	}
+}
+
+// FillPolygon fills the interior of the closed polygon defined by points
+// using a scanline even-odd fill: for each row, the polygon's edges are
+// intersected with the scanline, the crossings are sorted, and pixels between
+// each pair of crossings are plotted.
+func FillPolygon(points [][2]float64, plot PlotFunc) {
+	if len(points) < 3 {
+		return
+	}
+
+	minY, maxY := points[0][1], points[0][1]
+	for _, p := range points {
+		minY = math.Min(minY, p[1])
+		maxY = math.Max(maxY, p[1])
+	}
+
+	for y := int(math.Floor(minY)); y <= int(math.Ceil(maxY)); y++ {
+		yf := float64(y) + 0.5
+
+		var crossings []float64
+		for i := range points {
+			a, b := points[i], points[(i+1)%len(points)]
+			if (a[1] <= yf) == (b[1] <= yf) {
+				continue
+			}
+			t := (yf - a[1]) / (b[1] - a[1])
+			crossings = append(crossings, a[0]+t*(b[0]-a[0]))
+		}
+		sort.Float64s(crossings)
+
+		for i := 0; i+1 < len(crossings); i += 2 {
+			x0 := int(math.Round(crossings[i]))
+			x1 := int(math.Round(crossings[i+1]))
+			for x := x0; x <= x1; x++ {
+				plot(x, y, 1)
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package raster
+
+import (
+	"sort"
+	"testing"
+)
+
+// pixel records a single plotted pixel and its coverage.
+type pixel struct {
+	x, y     int
+	coverage float64
+}
+
+func collect(f func(plot PlotFunc)) []pixel {
+	var got []pixel
+	f(func(x, y int, coverage float64) {
+		got = append(got, pixel{x, y, coverage})
+	})
+	return got
+}
+
+func sortedPoints(pixels []pixel) [][2]int {
+	points := make([][2]int, len(pixels))
+	for i, p := range pixels {
+		points[i] = [2]int{p.x, p.y}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i][1] != points[j][1] {
+			return points[i][1] < points[j][1]
+		}
+		return points[i][0] < points[j][0]
+	})
+	return points
+}
+
+func TestFillPolygonSquare(t *testing.T) {
+	square := [][2]float64{{0, 0}, {4, 0}, {4, 4}, {0, 4}}
+	got := sortedPoints(collect(func(plot PlotFunc) { FillPolygon(square, plot) }))
+
+	var want [][2]int
+	for y := 0; y < 4; y++ {
+		for x := 0; x <= 4; x++ {
+			want = append(want, [2]int{x, y})
+		}
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d pixels, want %d: got=%v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("pixel %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFillPolygonTriangle(t *testing.T) {
+	triangle := [][2]float64{{0, 0}, {4, 0}, {0, 4}}
+	got := sortedPoints(collect(func(plot PlotFunc) { FillPolygon(triangle, plot) }))
+
+	want := [][2]int{
+		{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0},
+		{0, 1}, {1, 1}, {2, 1},
+		{0, 2}, {1, 2}, {2, 2},
+		{0, 3},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d pixels, want %d: got=%v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("pixel %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFillPolygonTooFewPoints(t *testing.T) {
+	got := collect(func(plot PlotFunc) { FillPolygon([][2]float64{{0, 0}, {1, 1}}, plot) })
+	if len(got) != 0 {
+		t.Fatalf("expected no pixels for a degenerate polygon, got %v", got)
+	}
+}
+
+func TestDrawLineHorizontal(t *testing.T) {
+	got := collect(func(plot PlotFunc) { DrawLine(0, 0, 4, 0, plot) })
+
+	for x := 0; x <= 4; x++ {
+		found := false
+		for _, p := range got {
+			if p.x == x && p.y == 0 {
+				if p.coverage != 1 {
+					t.Fatalf("pixel (%d,0): got coverage %v, want 1", x, p.coverage)
+				}
+				found = true
+			}
+			if p.x == x && p.y == 1 && p.coverage != 0 {
+				t.Fatalf("pixel (%d,1): got coverage %v, want 0", x, p.coverage)
+			}
+		}
+		if !found {
+			t.Fatalf("missing fully-covered pixel at (%d,0)", x)
+		}
+	}
+}
+
+func TestDrawLineDiagonalIsFullyCovered(t *testing.T) {
+	got := collect(func(plot PlotFunc) { DrawLine(0, 0, 3, 3, plot) })
+
+	want := map[[2]int]bool{{0, 0}: true, {1, 1}: true, {2, 2}: true, {3, 3}: true}
+	for point := range want {
+		found := false
+		for _, p := range got {
+			if p.x == point[0] && p.y == point[1] {
+				if p.coverage != 1 {
+					t.Fatalf("pixel %v: got coverage %v, want 1", point, p.coverage)
+				}
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("missing fully-covered pixel at %v", point)
+		}
+	}
+}
+
+func TestDrawCircleFilledIsSymmetric(t *testing.T) {
+	got := sortedPoints(collect(func(plot PlotFunc) { DrawCircleFilled(0, 0, 2, plot) }))
+
+	want := [][2]int{
+		{-2, -1}, {-2, 0}, {-2, 1},
+		{-1, -2}, {-1, -1}, {-1, 0}, {-1, 1}, {-1, 2},
+		{0, -2}, {0, -1}, {0, 0}, {0, 1}, {0, 2},
+		{1, -2}, {1, -1}, {1, 0}, {1, 1}, {1, 2},
+		{2, -1}, {2, 0}, {2, 1},
+	}
+
+	byKey := make(map[[2]int]bool, len(got))
+	for _, p := range got {
+		byKey[p] = true
+	}
+	for _, w := range want {
+		if !byKey[w] {
+			t.Errorf("missing pixel %v in filled circle", w)
+		}
+	}
+
+	// The centre must always be filled, and the shape must be symmetric about it.
+	if !byKey[[2]int{0, 0}] {
+		t.Fatalf("centre pixel (0,0) not filled")
+	}
+	for _, p := range got {
+		mirror := [2]int{-p[0], -p[1]}
+		if !byKey[mirror] {
+			t.Errorf("pixel %v filled without its point-symmetric counterpart %v", p, mirror)
+		}
+	}
+}
+
+func TestDrawCircleOutlineIsSymmetric(t *testing.T) {
+	got := collect(func(plot PlotFunc) { DrawCircleOutline(0, 0, 5, plot) })
+	if len(got) == 0 {
+		t.Fatal("expected DrawCircleOutline to plot at least one pixel")
+	}
+
+	byKey := make(map[[2]int]float64, len(got))
+	for _, p := range got {
+		byKey[[2]int{p.x, p.y}] += p.coverage
+	}
+	for k, coverage := range byKey {
+		mirror := [2]int{-k[0], k[1]}
+		if _, ok := byKey[mirror]; !ok {
+			t.Errorf("pixel %v plotted without its horizontally-mirrored counterpart %v", k, mirror)
+		}
+		if coverage <= 0 || coverage > 8 {
+			t.Errorf("pixel %v has implausible accumulated coverage %v", k, coverage)
+		}
+	}
+}
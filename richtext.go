@@ -0,0 +1,158 @@
+package turdgl
+
+import (
+	"image/color"
+	"regexp"
+	"strings"
+)
+
+// Run is a contiguous span of text sharing a single colour.
+type Run struct {
+	Text   string
+	Colour color.Color
+}
+
+// markupTokenRe matches an inline colour token in the form "{#rrggbb}" and
+// its corresponding closing "{/}".
+var markupTokenRe = regexp.MustCompile(`\{(#[0-9a-fA-F]{6}|/)\}`)
+
+// ParseMarkup parses a string containing inline colour tokens, e.g.
+// "Score: {#ff0000}42{/} pts", into a sequence of coloured runs. Text
+// outside any token uses defaultColour.
+func ParseMarkup(s string, defaultColour color.Color) []Run {
+	var runs []Run
+	current := defaultColour
+
+	matches := markupTokenRe.FindAllStringSubmatchIndex(s, -1)
+	pos := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if start > pos {
+			runs = append(runs, Run{Text: s[pos:start], Colour: current})
+		}
+		token := s[m[2]:m[3]]
+		if token == "/" {
+			current = defaultColour
+		} else {
+			current = parseHexColour(token)
+		}
+		pos = end
+	}
+	if pos < len(s) {
+		runs = append(runs, Run{Text: s[pos:], Colour: current})
+	}
+	return runs
+}
+
+// parseHexColour parses a "#rrggbb" string into an opaque colour.
+func parseHexColour(s string) color.Color {
+	var r, g, b uint8
+	hexNibble := func(c byte) uint8 {
+		switch {
+		case c >= '0' && c <= '9':
+			return c - '0'
+		case c >= 'a' && c <= 'f':
+			return c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			return c - 'A' + 10
+		default:
+			return 0
+		}
+	}
+	hexByte := func(hi, lo byte) uint8 {
+		return hexNibble(hi)<<4 | hexNibble(lo)
+	}
+	r = hexByte(s[1], s[2])
+	g = hexByte(s[3], s[4])
+	b = hexByte(s[5], s[6])
+	return color.RGBA{r, g, b, 0xff}
+}
+
+// SetMarkup parses s for inline colour tokens (e.g. "Score: {#ff0000}42{/} pts")
+// and sets the text's runs accordingly. The text's plain contents (as
+// returned by Width, and as drawn by Draw) are the markup with its colour
+// tokens stripped out, so the raw markup is never rendered to screen.
+func (t *Text) SetMarkup(s string) {
+	t.runs = ParseMarkup(s, t.colour)
+
+	var plain strings.Builder
+	for _, r := range t.runs {
+		plain.WriteString(r.Text)
+	}
+	t.str = plain.String()
+}
+
+// AppendRun appends a single run of text in the given colour to the text's runs.
+func (t *Text) AppendRun(s string, c color.Color) {
+	t.runs = append(t.runs, Run{Text: s, Colour: c})
+	t.str += s
+}
+
+// Runs returns the text's current runs, as set by SetMarkup or AppendRun.
+func (t *Text) Runs() []Run {
+	return t.runs
+}
+
+// SetRunColorFn installs a hook that overrides the colour of each run by
+// index when the text is drawn, e.g. to animate individual runs.
+func (t *Text) SetRunColorFn(fn func(runIndex int) color.Color) {
+	t.runColorFn = fn
+}
+
+// DrawRuns renders the text's runs left-to-right onto buf, measuring each
+// run's advance width from the font size and wrapping onto a new line at run
+// boundaries (a run is never split mid-way to satisfy a wrap) whenever
+// WrapWidth is set and would otherwise be exceeded. A literal "\n" within a
+// run's text always starts a new line. Runs without a colour override from
+// SetRunColorFn keep the colour they were parsed or appended with. The first
+// line starts at Pos adjusted for the text's alignment; AlignCentre centres a
+// box of Width() by Height() on Pos. Called by Draw, so both plain and
+// marked-up text go through the same render path.
+func (t *Text) DrawRuns(buf *FrameBuffer) {
+	runs := t.runs
+	if len(runs) == 0 {
+		runs = []Run{{Text: t.str, Colour: t.colour}}
+	}
+
+	origin := t.Pos
+	switch t.alignment {
+	case AlignCentre:
+		origin = Vec{X: t.Pos.X - t.Width()/2, Y: t.Pos.Y - t.Height()/2}
+	case AlignCustom:
+		origin = Add(t.Pos, t.offset)
+	}
+
+	lineHeight := t.Height()
+	lineStartX := origin.X
+	cursor := origin
+
+	for i, run := range runs {
+		colour := run.Colour
+		if t.runColorFn != nil {
+			if c := t.runColorFn(i); c != nil {
+				colour = c
+			}
+		}
+
+		for segIdx, segment := range strings.Split(run.Text, "\n") {
+			if segIdx > 0 {
+				// an explicit newline within the run always starts a new line
+				cursor = Vec{X: lineStartX, Y: cursor.Y + lineHeight}
+			}
+
+			width := t.measure(segment)
+			if t.WrapWidth > 0 && cursor.X > lineStartX && cursor.X+width > lineStartX+t.WrapWidth {
+				// wrap at the run boundary rather than splitting the run itself
+				cursor = Vec{X: lineStartX, Y: cursor.Y + lineHeight}
+			}
+
+			t.drawCells(buf, segment, cursor, colour)
+			cursor.X += width
+		}
+	}
+}
+
+// measure returns the approximate pixel width of s rendered at the text's current font size.
+func (t *Text) measure(s string) float64 {
+	return float64(len(s)) * t.size * 0.6
+}
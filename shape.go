@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"image/color"
 	"math"
+
+	"github.com/zac460/turdgl/raster"
 )
 
 // Style contains style information for a shape.
@@ -18,6 +20,7 @@ type shape struct {
 	Direction Vec
 	w, h      float64
 	style     Style
+	theme     *Theme
 }
 
 // newShape constructs a new shape according to the supplied parameters.
@@ -36,17 +39,34 @@ func WithStyle(style Style) func(*shape) {
 	}
 }
 
-// defaultShape constructs a shape with default parameters.
+// WithTheme is used in the newShape constructor to override the shape's theme.
+func WithTheme(theme *Theme) func(*shape) {
+	return func(s *shape) {
+		s.theme = theme
+		s.style = theme.ShapeStyle
+	}
+}
+
+// defaultShape constructs a shape with default parameters, taking its style from
+// the currently active theme.
 func defaultShape(width, height float64, pos Vec) *shape {
+	theme := CurrentTheme()
 	return &shape{
 		Pos:       pos,
 		Direction: Normalise(Vec{0, -1}), // upwards
 		w:         width,
 		h:         height,
-		style:     Style{Colour: color.RGBA{0xff, 0xff, 0xff, 0xff}, Thickness: 0},
+		style:     theme.ShapeStyle,
+		theme:     theme,
 	}
 }
 
+// SetTheme overrides the theme used by this shape, re-deriving its style from it.
+func (s *shape) SetTheme(theme *Theme) {
+	s.theme = theme
+	s.style = theme.ShapeStyle
+}
+
 // Width returns the width of the shape.
 func (s *shape) Width() float64 {
 	return s.w
@@ -57,6 +77,14 @@ func (s *shape) Height() float64 {
 	return s.h
 }
 
+// SetSize resizes the shape to the given width and height. This lets layout
+// containers (see the layout package) stretch a shape to fill its share of
+// the space they arrange it into.
+func (s *shape) SetSize(width, height float64) {
+	s.w = width
+	s.h = height
+}
+
 // Move modifies the position of the shape by the given vector.
 func (s *shape) Move(mov Vec) {
 	s.Pos.X += mov.X
@@ -68,12 +96,17 @@ func (s *shape) SetPos(v Vec) {
 	s.Pos = v
 }
 
+// SetStyle sets the style of the shape.
+func (s *shape) SetStyle(style Style) {
+	s.style = style
+}
+
 // Rect is a rectangle shape, aligned to the top-left corner.
 type Rect struct{ *shape }
 
 // NewRect constructs a new rectangle shape.
 func NewRect(width, height float64, pos Vec, opts ...func(*shape)) *Rect {
-	return &Rect{newShape(width, height, pos)}
+	return &Rect{newShape(width, height, pos, opts...)}
 }
 
 // Draw draws the rectangle onto the provided frame buffer.
@@ -114,37 +147,40 @@ func NewCircle(diameter float64, pos Vec, opts ...func(*shape)) *Circle {
 	return &Circle{newShape(diameter, diameter, pos, opts...)}
 }
 
-// Draw draws the circle onto the provided frame buffer.
+// Draw draws the circle onto the provided frame buffer. Filled circles are
+// rasterised with the midpoint circle algorithm (horizontal spans rather than
+// a bounding-box scan); outlines are antialiased with Xiaolin Wu's algorithm.
 func (c *Circle) Draw(buf *FrameBuffer) {
 	if c.w != c.h {
 		fmt.Println("w:", c.w, "h:", c.h)
 		panic("circle width and height must match")
 	}
 
-	// Construct bounding box
 	radius := c.w / 2
-	bbBoxPos := Vec{c.Pos.X - (radius), c.Pos.Y - (radius)}
-	bbox := NewRect(c.w, c.h, bbBoxPos)
-
-	// Iterate over every pixel in the bounding box
-	for i := bbox.Pos.X; i <= bbox.Pos.X+bbox.w; i++ {
-		for j := bbox.Pos.Y; j <= bbox.Pos.Y+bbox.h; j++ {
-			// Draw pixel if it's close enough to centre
-			dist := Dist(c.Pos, Vec{i, j})
-			jInt, iInt := int(math.Round(j)), int(math.Round(i))
-			if c.style.Thickness == 0 {
-				// Solid fill
-				if dist <= float64(radius) {
-					buf.SetPixel(jInt, iInt, NewPixel(c.style.Colour))
-				}
-			} else {
-				// Outline
-				if dist >= float64(radius-c.style.Thickness) && dist <= float64(radius) {
-					buf.SetPixel(jInt, iInt, NewPixel(c.style.Colour))
-
-				}
-			}
-		}
+	plot := func(x, y int, coverage float64) {
+		buf.SetPixel(y, x, NewPixel(blendAlpha(c.style.Colour, coverage)))
+	}
+
+	if c.style.Thickness == 0 {
+		raster.DrawCircleFilled(c.Pos.X, c.Pos.Y, radius, plot)
+		return
+	}
+
+	for r := radius - c.style.Thickness + 1; r <= radius; r++ {
+		raster.DrawCircleOutline(c.Pos.X, c.Pos.Y, r, plot)
+	}
+}
+
+// blendAlpha scales the alpha channel of c by coverage, which is in the
+// range [0,1]. It is used to apply antialiasing coverage computed by the
+// raster package.
+func blendAlpha(c color.Color, coverage float64) color.Color {
+	r, g, b, a := c.RGBA()
+	return color.RGBA{
+		R: uint8(r >> 8),
+		G: uint8(g >> 8),
+		B: uint8(b >> 8),
+		A: uint8(float64(a>>8) * coverage),
 	}
 }
 
@@ -153,3 +189,218 @@ func (c *Circle) Draw(buf *FrameBuffer) {
 func (c *Circle) EdgePoint(theta float64) Vec {
 	return Add(c.Pos, (c.Direction.SetMag(c.Width() / 2).Rotate(theta)))
 }
+
+// Line is a straight line segment between two points.
+type Line struct {
+	Start, End Vec
+	style      Style
+}
+
+// NewLine constructs a new line between start and end.
+func NewLine(start, end Vec, opts ...func(*Line)) *Line {
+	l := &Line{Start: start, End: end, style: CurrentTheme().ShapeStyle}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// WithLineStyle is used in the NewLine constructor for setting a line's style.
+func WithLineStyle(style Style) func(*Line) {
+	return func(l *Line) {
+		l.style = style
+	}
+}
+
+// GetPos returns the line's start point.
+func (l *Line) GetPos() Vec {
+	return l.Start
+}
+
+// SetPos moves the line's start point to pos, translating the end point by
+// the same amount so the line keeps its length and direction.
+func (l *Line) SetPos(pos Vec) {
+	delta := Sub(pos, l.Start)
+	l.Start = pos
+	l.End = Add(l.End, delta)
+}
+
+// Move moves the line by the given vector.
+func (l *Line) Move(mov Vec) {
+	l.Start = Add(l.Start, mov)
+	l.End = Add(l.End, mov)
+}
+
+// Width returns the horizontal extent of the line.
+func (l *Line) Width() float64 {
+	return math.Abs(l.End.X - l.Start.X)
+}
+
+// Height returns the vertical extent of the line.
+func (l *Line) Height() float64 {
+	return math.Abs(l.End.Y - l.Start.Y)
+}
+
+// SetStyle sets the style of the line.
+func (l *Line) SetStyle(style Style) {
+	l.style = style
+}
+
+// Draw draws the antialiased line onto the provided frame buffer.
+func (l *Line) Draw(buf *FrameBuffer) {
+	plot := func(x, y int, coverage float64) {
+		buf.SetPixel(y, x, NewPixel(blendAlpha(l.style.Colour, coverage)))
+	}
+	raster.DrawLine(l.Start.X, l.Start.Y, l.End.X, l.End.Y, plot)
+}
+
+// SymbolKind identifies which glyph a Symbol renders.
+type SymbolKind int
+
+const (
+	SymbolX SymbolKind = iota
+	SymbolPlus
+	SymbolMinus
+	SymbolTriangleUp
+	SymbolTriangleDown
+	SymbolTriangleLeft
+	SymbolTriangleRight
+	SymbolCheck
+	SymbolRectFilled
+	SymbolCircleFilled
+)
+
+// Symbol is a small vector glyph rendered inside a bounding box, useful for
+// building icon-only buttons (close, next/prev, run-toggle, ...) without
+// shipping image assets.
+type Symbol struct {
+	*shape
+	Kind SymbolKind
+	Fill color.Color // optional fill colour; nil leaves triangles unfilled and fills SymbolRectFilled/SymbolCircleFilled with the stroke colour
+}
+
+// NewSymbol constructs a new symbol of the given kind, sized to fit width x height.
+func NewSymbol(kind SymbolKind, width, height float64, pos Vec, opts ...func(*shape)) *Symbol {
+	return &Symbol{shape: newShape(width, height, pos, opts...), Kind: kind}
+}
+
+// IsWithin returns whether the given point lies within the symbol's bounding box.
+func (s *Symbol) IsWithin(v Vec) bool {
+	return v.X >= s.Pos.X && v.X <= s.Pos.X+s.w && v.Y >= s.Pos.Y && v.Y <= s.Pos.Y+s.h
+}
+
+// SetFill sets the symbol's fill colour.
+func (s *Symbol) SetFill(c color.Color) *Symbol {
+	s.Fill = c
+	return s
+}
+
+// Draw draws the symbol onto the provided frame buffer.
+func (s *Symbol) Draw(buf *FrameBuffer) {
+	switch s.Kind {
+	case SymbolX:
+		s.drawX(buf)
+	case SymbolPlus:
+		s.drawPlus(buf)
+	case SymbolMinus:
+		s.drawMinus(buf)
+	case SymbolTriangleUp, SymbolTriangleDown, SymbolTriangleLeft, SymbolTriangleRight:
+		s.drawTriangle(buf)
+	case SymbolCheck:
+		s.drawCheck(buf)
+	case SymbolRectFilled:
+		NewRect(s.w, s.h, s.Pos, WithStyle(Style{Colour: s.fillOrStroke(), Thickness: 0})).Draw(buf)
+	case SymbolCircleFilled:
+		diameter := math.Min(s.w, s.h)
+		centre := Vec{s.Pos.X + s.w/2, s.Pos.Y + s.h/2}
+		NewCircle(diameter, centre, WithStyle(Style{Colour: s.fillOrStroke(), Thickness: 0})).Draw(buf)
+	}
+}
+
+// fillOrStroke returns the symbol's fill colour if set, otherwise its stroke colour.
+func (s *Symbol) fillOrStroke() color.Color {
+	if s.Fill != nil {
+		return s.Fill
+	}
+	return s.style.Colour
+}
+
+func (s *Symbol) drawLine(buf *FrameBuffer, a, b Vec) {
+	NewLine(a, b, WithLineStyle(s.style)).Draw(buf)
+}
+
+func (s *Symbol) drawX(buf *FrameBuffer) {
+	topLeft := s.Pos
+	topRight := Vec{s.Pos.X + s.w, s.Pos.Y}
+	bottomLeft := Vec{s.Pos.X, s.Pos.Y + s.h}
+	bottomRight := Vec{s.Pos.X + s.w, s.Pos.Y + s.h}
+	s.drawLine(buf, topLeft, bottomRight)
+	s.drawLine(buf, topRight, bottomLeft)
+}
+
+func (s *Symbol) drawPlus(buf *FrameBuffer) {
+	thickness := s.style.Thickness
+	if thickness == 0 {
+		thickness = math.Max(s.w, s.h) / 5
+	}
+	midX, midY := s.Pos.X+s.w/2, s.Pos.Y+s.h/2
+	NewRect(s.w, thickness, Vec{s.Pos.X, midY - thickness/2}, WithStyle(Style{Colour: s.style.Colour, Thickness: 0})).Draw(buf)
+	NewRect(thickness, s.h, Vec{midX - thickness/2, s.Pos.Y}, WithStyle(Style{Colour: s.style.Colour, Thickness: 0})).Draw(buf)
+}
+
+func (s *Symbol) drawMinus(buf *FrameBuffer) {
+	thickness := s.style.Thickness
+	if thickness == 0 {
+		thickness = math.Max(s.w, s.h) / 5
+	}
+	midY := s.Pos.Y + s.h/2
+	NewRect(s.w, thickness, Vec{s.Pos.X, midY - thickness/2}, WithStyle(Style{Colour: s.style.Colour, Thickness: 0})).Draw(buf)
+}
+
+// triangleFromDirection computes the three vertices of a triangle that fits
+// the symbol's bounding box and points in the heading given by its Kind.
+func (s *Symbol) triangleFromDirection() (a, b, c Vec) {
+	left := s.Pos.X
+	right := s.Pos.X + s.w
+	top := s.Pos.Y
+	bottom := s.Pos.Y + s.h
+	midX := s.Pos.X + s.w/2
+	midY := s.Pos.Y + s.h/2
+
+	switch s.Kind {
+	case SymbolTriangleUp:
+		return Vec{midX, top}, Vec{left, bottom}, Vec{right, bottom}
+	case SymbolTriangleDown:
+		return Vec{left, top}, Vec{right, top}, Vec{midX, bottom}
+	case SymbolTriangleLeft:
+		return Vec{left, midY}, Vec{right, top}, Vec{right, bottom}
+	case SymbolTriangleRight:
+		return Vec{right, midY}, Vec{left, top}, Vec{left, bottom}
+	default:
+		return Vec{midX, top}, Vec{left, bottom}, Vec{right, bottom}
+	}
+}
+
+func (s *Symbol) drawTriangle(buf *FrameBuffer) {
+	a, b, c := s.triangleFromDirection()
+	if s.Fill != nil {
+		raster.FillPolygon([][2]float64{{a.X, a.Y}, {b.X, b.Y}, {c.X, c.Y}}, func(x, y int, coverage float64) {
+			buf.SetPixel(y, x, NewPixel(blendAlpha(s.Fill, coverage)))
+		})
+	}
+	s.drawLine(buf, a, b)
+	s.drawLine(buf, b, c)
+	s.drawLine(buf, c, a)
+}
+
+func (s *Symbol) drawCheck(buf *FrameBuffer) {
+	left := s.Pos.X
+	right := s.Pos.X + s.w
+	top := s.Pos.Y
+	bottom := s.Pos.Y + s.h
+	midY := s.Pos.Y + s.h/2
+
+	elbow := Vec{left + s.w*0.35, bottom}
+	s.drawLine(buf, Vec{left, midY}, elbow)
+	s.drawLine(buf, elbow, Vec{right, top})
+}
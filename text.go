@@ -0,0 +1,171 @@
+package turdgl
+
+import "image/color"
+
+// Alignment specifies how a Text's rendered string is positioned relative to its anchor point.
+type Alignment int
+
+const (
+	AlignCentre  Alignment = iota // centred on Pos
+	AlignTopLeft                  // top-left corner at Pos
+	AlignCustom                   // offset from Pos by a caller-supplied amount, see SetOffset
+)
+
+// Text is a renderable, positionable string of characters.
+type Text struct {
+	Pos Vec
+
+	str       string
+	colour    color.Color
+	fontPath  string
+	dpi       float64
+	size      float64
+	spacing   float64
+	maskW     int
+	maskH     int
+	alignment Alignment
+	offset    Vec
+
+	// runs holds the coloured spans set by SetMarkup/AppendRun. When empty,
+	// Draw renders str as a single run in colour.
+	runs       []Run
+	runColorFn func(runIndex int) color.Color
+
+	// WrapWidth, if non-zero, is the maximum line width in pixels before a
+	// run boundary is wrapped onto a new line.
+	WrapWidth float64
+
+	theme *Theme
+}
+
+// NewText constructs a new text widget at pos, using fontPath to render str.
+// Font and colour defaults are taken from the currently active theme; if
+// fontPath is empty, the theme's own FontPath is used instead.
+func NewText(str string, pos Vec, fontPath string) *Text {
+	theme := CurrentTheme()
+	if fontPath == "" {
+		fontPath = theme.FontPath
+	}
+	return &Text{
+		Pos:       pos,
+		str:       str,
+		colour:    theme.TextColour,
+		fontPath:  fontPath,
+		dpi:       theme.FontDPI,
+		size:      theme.FontSize,
+		spacing:   theme.FontSpacing,
+		maskW:     int(theme.Scale(128)),
+		maskH:     int(theme.Scale(32)),
+		alignment: AlignCentre,
+		theme:     theme,
+	}
+}
+
+// SetTheme overrides the theme used by this text widget, re-deriving its
+// cached colour, font metrics and mask size from it.
+func (t *Text) SetTheme(theme *Theme) *Text {
+	t.theme = theme
+	t.colour = theme.TextColour
+	t.dpi = theme.FontDPI
+	t.size = theme.FontSize
+	t.spacing = theme.FontSpacing
+	t.maskW = int(theme.Scale(128))
+	t.maskH = int(theme.Scale(32))
+	return t
+}
+
+// GetPos returns the text's anchor position.
+func (t *Text) GetPos() Vec {
+	return t.Pos
+}
+
+// SetPos sets the text's anchor position.
+func (t *Text) SetPos(pos Vec) {
+	t.Pos = pos
+}
+
+// Move moves the text by the given vector.
+func (t *Text) Move(mov Vec) {
+	t.Pos = Add(t.Pos, mov)
+}
+
+// SetText sets the text's contents to s, discarding any runs set by
+// SetMarkup/AppendRun so that s is drawn in the text's own colour.
+func (t *Text) SetText(s string) {
+	t.str = s
+	t.runs = nil
+}
+
+// SetAlignment sets the alignment of the text relative to its anchor position.
+func (t *Text) SetAlignment(align Alignment) {
+	t.alignment = align
+}
+
+// SetOffset manually sets the text's offset, providing the text is in AlignCustom mode.
+func (t *Text) SetOffset(offset Vec) {
+	t.offset = offset
+}
+
+// SetColour sets the text colour.
+func (t *Text) SetColour(c color.Color) {
+	t.colour = c
+}
+
+// SetFont sets the path to the .ttf file used to render the text.
+func (t *Text) SetFont(path string) {
+	t.fontPath = path
+}
+
+// FontPath returns the path to the .ttf file used to render the text.
+func (t *Text) FontPath() string {
+	return t.fontPath
+}
+
+// SetDPI sets the DPI of the font.
+func (t *Text) SetDPI(dpi float64) {
+	t.dpi = dpi
+}
+
+// SetSize sets the size of the font.
+func (t *Text) SetSize(size float64) {
+	t.size = size
+}
+
+// SetSpacing sets the line spacing of the text.
+func (t *Text) SetSpacing(spacing float64) {
+	t.spacing = spacing
+}
+
+// SetMaskSize sets the size of the mask used to generate the text.
+func (t *Text) SetMaskSize(w, h int) {
+	t.maskW, t.maskH = w, h
+}
+
+// Width returns the approximate rendered width of the text's current contents.
+func (t *Text) Width() float64 {
+	return float64(len(t.str)) * t.size * 0.6
+}
+
+// Height returns the rendered height of a single line of text.
+func (t *Text) Height() float64 {
+	return t.size * t.spacing
+}
+
+// Draw draws the text onto the provided frame buffer, rendering any runs set
+// via SetMarkup/AppendRun in colour.
+func (t *Text) Draw(buf *FrameBuffer) {
+	t.DrawRuns(buf)
+}
+
+// drawCells renders s as a row of simple coloured cells starting at pos — a
+// lightweight placeholder for full glyph rasterisation.
+func (t *Text) drawCells(buf *FrameBuffer, s string, pos Vec, colour color.Color) {
+	cellW := t.size * 0.6
+	for i := range s {
+		if s[i] == ' ' {
+			continue
+		}
+		cellPos := Vec{X: pos.X + float64(i)*cellW, Y: pos.Y}
+		NewRect(cellW*0.8, t.size, cellPos, WithStyle(Style{Colour: colour, Thickness: 0})).Draw(buf)
+	}
+}
@@ -0,0 +1,71 @@
+package turdgl
+
+import "image/color"
+
+// Theme holds the default styles and colours used to construct shapes, text
+// and buttons. Widgets consult the active theme at construction time, and
+// keep a pointer to it so it can later be swapped out on a per-instance basis.
+type Theme struct {
+	ShapeStyle Style // default style for Rect/Circle
+
+	ButtonStyles      map[ButtonState]Style       // default per-state button styles
+	ButtonLabelColour map[ButtonState]color.Color // default per-state label colours
+
+	TextColour  color.Color // default text colour
+	FontPath    string      // default .ttf file used when none is supplied
+	FontDPI     float64     // default font DPI
+	FontSize    float64     // default font size
+	FontSpacing float64     // default line spacing
+
+	scale float64 // DPI scale factor applied by Scale
+}
+
+// NewDefaultTheme constructs the theme turdgl falls back to when no theme has
+// been set.
+func NewDefaultTheme() *Theme {
+	white := color.RGBA{0xff, 0xff, 0xff, 0xff}
+	return &Theme{
+		ShapeStyle: Style{Colour: white, Thickness: 0},
+		ButtonStyles: map[ButtonState]Style{
+			StateNormal: {Colour: white, Thickness: 0},
+		},
+		ButtonLabelColour: map[ButtonState]color.Color{
+			StateNormal: white,
+		},
+		TextColour:  white,
+		FontDPI:     72,
+		FontSize:    12,
+		FontSpacing: 1,
+		scale:       1,
+	}
+}
+
+// DefaultTheme is the theme used by constructors when no other theme has been set.
+var DefaultTheme = NewDefaultTheme()
+
+// activeTheme is the theme consulted by widget constructors.
+var activeTheme = DefaultTheme
+
+// SetTheme replaces the theme consulted by widget constructors from this point on.
+// It does not affect widgets that have already been constructed.
+func SetTheme(t *Theme) {
+	activeTheme = t
+}
+
+// CurrentTheme returns the theme currently consulted by widget constructors.
+func CurrentTheme() *Theme {
+	return activeTheme
+}
+
+// Scale returns size scaled according to the theme's DPI scale factor.
+func (t *Theme) Scale(size float64) float64 {
+	if t.scale == 0 {
+		return size
+	}
+	return size * t.scale
+}
+
+// SetScale sets the DPI scale factor applied by Scale.
+func (t *Theme) SetScale(scale float64) {
+	t.scale = scale
+}
@@ -0,0 +1,37 @@
+package turdgl
+
+// Window represents the on-screen window that shapes and widgets are drawn
+// into and that user input is read from.
+type Window struct {
+	mouseState MouseState
+	mouseLoc   Vec
+
+	focusManager *FocusManager
+}
+
+// NewWindow constructs a new window.
+func NewWindow() *Window {
+	return &Window{}
+}
+
+// MouseButtonState returns the current state of the mouse buttons.
+func (w *Window) MouseButtonState() MouseState {
+	return w.mouseState
+}
+
+// MouseLocation returns the current cursor location.
+func (w *Window) MouseLocation() Vec {
+	return w.mouseLoc
+}
+
+// SetMouseButtonState sets the window's current mouse button state. It is
+// called by the window's event loop as input is received.
+func (w *Window) SetMouseButtonState(s MouseState) {
+	w.mouseState = s
+}
+
+// SetMouseLocation sets the window's current cursor location. It is called
+// by the window's event loop as input is received.
+func (w *Window) SetMouseLocation(loc Vec) {
+	w.mouseLoc = loc
+}